@@ -0,0 +1,23 @@
+;; Validator that allows every call.
+(module
+  (memory (export "memory") 1)
+  (data (i32.const 0) "{\"allow\":true}")
+  ;; Bump allocator for the proxy's input; memory grows as needed.
+  (global $next (mut i32) (i32.const 1024))
+  (func (export "aip_alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    (local.set $ptr (global.get $next))
+    (global.set $next (i32.add (local.get $ptr) (local.get $size)))
+    (if (i32.gt_u (global.get $next) (i32.shl (memory.size) (i32.const 16)))
+      (then
+        (drop (memory.grow
+          (i32.add
+            (i32.shr_u
+              (i32.sub (global.get $next) (i32.shl (memory.size) (i32.const 16)))
+              (i32.const 16))
+            (i32.const 1))))))
+    (local.get $ptr))
+
+  (func (export "aip_validate") (param $ptr i32) (param $len i32) (result i64)
+    (i64.const 14))
+)
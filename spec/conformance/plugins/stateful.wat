@@ -0,0 +1,29 @@
+;; Validator that allows only the first call it sees. Because no state
+;; carries between invocations, every call is its first.
+(module
+  (memory (export "memory") 1)
+  (data (i32.const 0) "{\"allow\":true}")
+  (data (i32.const 64) "{\"allow\":false,\"reason\":\"seen_before\"}")
+  (global $calls (mut i32) (i32.const 0))
+  ;; Bump allocator for the proxy's input; memory grows as needed.
+  (global $next (mut i32) (i32.const 1024))
+  (func (export "aip_alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    (local.set $ptr (global.get $next))
+    (global.set $next (i32.add (local.get $ptr) (local.get $size)))
+    (if (i32.gt_u (global.get $next) (i32.shl (memory.size) (i32.const 16)))
+      (then
+        (drop (memory.grow
+          (i32.add
+            (i32.shr_u
+              (i32.sub (global.get $next) (i32.shl (memory.size) (i32.const 16)))
+              (i32.const 16))
+            (i32.const 1))))))
+    (local.get $ptr))
+
+  (func (export "aip_validate") (param $ptr i32) (param $len i32) (result i64)
+    (global.set $calls (i32.add (global.get $calls) (i32.const 1)))
+    (if (result i64) (i32.eq (global.get $calls) (i32.const 1))
+      (then (i64.const 14))
+      (else (i64.or (i64.shl (i64.const 64) (i64.const 32)) (i64.const 38)))))
+)